@@ -13,58 +13,156 @@ var errorInterface = reflect.TypeOf((*error)(nil)).Elem()
 // ArgsTo and the returns with ParseReturn. fn argument can be a function
 // or a reflect.Value for a function.
 func Call(fn any, args []any) (_ []any, err error) {
+	return CallWith(fn, args)
+}
+
+// CallWith is like Call but lets callers customize the mapstructure
+// decoding behavior used to convert args, e.g. WithDecodeHook for custom
+// type conversions or WithWeaklyTypedInput for loosely-typed JSON payloads.
+func CallWith(fn any, args []any, opts ...Option) (_ []any, err error) {
 	fnval := reflect.ValueOf(fn)
 	if rv, ok := fn.(reflect.Value); ok {
 		fnval = rv
 	}
-	fnParams, err := ArgsTo(fnval.Type(), args)
+	fntyp := fnval.Type()
+	fnParams, err := ArgsTo(fntyp, args, opts...)
 	if err != nil {
 		return nil, err
 	}
-	fnReturn := fnval.Call(fnParams)
+	var fnReturn []reflect.Value
+	if useCallSlice(fntyp, fnParams) {
+		fnReturn = fnval.CallSlice(fnParams)
+	} else {
+		fnReturn = fnval.Call(fnParams)
+	}
 	return ParseReturn(fnReturn)
 }
 
+// useCallSlice reports whether fnParams already ends in a pre-built slice
+// for fntyp's variadic parameter, in which case it must be dispatched with
+// reflect.Value.CallSlice instead of reflect.Value.Call.
+func useCallSlice(fntyp reflect.Type, fnParams []reflect.Value) bool {
+	if !fntyp.IsVariadic() || len(fnParams) != fntyp.NumIn() {
+		return false
+	}
+	last := fnParams[len(fnParams)-1]
+	return last.IsValid() && last.Type() == fntyp.In(fntyp.NumIn()-1)
+}
+
 // ArgsTo converts the arguments into `reflect.Value`s suitable to pass as
-// parameters to a function with the given type via reflection.
-func ArgsTo(fntyp reflect.Type, args []any) ([]reflect.Value, error) {
-	if len(args) != fntyp.NumIn() {
-		return nil, fmt.Errorf("fn: expected %d params, got %d", fntyp.NumIn(), len(args))
-	}
-	fnParams := make([]reflect.Value, len(args))
-	for idx, param := range args {
-		switch fntyp.In(idx).Kind() {
-		case reflect.Struct:
-			// decode to struct type using mapstructure
-			arg := reflect.New(fntyp.In(idx))
-			if err := mapstructure.Decode(param, arg.Interface()); err != nil {
-				return nil, fmt.Errorf("fn: mapstructure: %s", err.Error())
+// parameters to a function with the given type via reflection. If fntyp is
+// variadic, the trailing args are accepted either as a flat tail of scalar
+// values or as a single, already-built slice matching the variadic
+// parameter's type.
+func ArgsTo(fntyp reflect.Type, args []any, opts ...Option) ([]reflect.Value, error) {
+	fixed := fntyp.NumIn()
+	if fntyp.IsVariadic() {
+		fixed--
+		if len(args) < fixed {
+			return nil, fmt.Errorf("fn: expected at least %d params, got %d", fixed, len(args))
+		}
+	} else if len(args) != fixed {
+		return nil, fmt.Errorf("fn: expected %d params, got %d", fixed, len(args))
+	}
+
+	cfg := newDecoderConfig(opts)
+	fnParams := make([]reflect.Value, 0, len(args))
+	for idx := 0; idx < fixed; idx++ {
+		v, err := convertArg(fntyp.In(idx), args[idx], cfg)
+		if err != nil {
+			return nil, err
+		}
+		fnParams = append(fnParams, v)
+	}
+	if !fntyp.IsVariadic() {
+		return fnParams, nil
+	}
+
+	tail := args[fixed:]
+	sliceType := fntyp.In(fixed)
+	if len(tail) == 1 {
+		if rv := reflect.ValueOf(tail[0]); rv.IsValid() && rv.Kind() == reflect.Slice {
+			// pre-built slice: run each element through the same
+			// struct/mapstructure conversion as everywhere else in ArgsTo,
+			// rather than requiring it already be a []elemType.
+			built := reflect.MakeSlice(sliceType, rv.Len(), rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				v, err := convertArg(sliceType.Elem(), rv.Index(i).Interface(), cfg)
+				if err != nil {
+					return nil, err
+				}
+				built.Index(i).Set(v)
 			}
-			fnParams[idx] = ensureType(arg.Elem(), fntyp.In(idx))
-		case reflect.Slice:
+			return append(fnParams, built), nil
+		}
+	}
+	for _, param := range tail {
+		v, err := convertArg(sliceType.Elem(), param, cfg)
+		if err != nil {
+			return nil, err
+		}
+		fnParams = append(fnParams, v)
+	}
+	return fnParams, nil
+}
+
+// convertArg converts a single argument to a reflect.Value of type t,
+// applying the same struct/slice mapstructure decoding used by ArgsTo for
+// both fixed and variadic parameters.
+func convertArg(t reflect.Type, param any, cfg *mapstructure.DecoderConfig) (reflect.Value, error) {
+	switch t.Kind() {
+	case reflect.Struct:
+		// decode to struct type using mapstructure
+		arg := reflect.New(t)
+		if err := decodeInto(cfg, param, arg.Interface()); err != nil {
+			return reflect.Value{}, fmt.Errorf("fn: mapstructure: %w", err)
+		}
+		return ensureType(arg.Elem(), t), nil
+	case reflect.Pointer:
+		// decode to *struct type using mapstructure
+		if t.Elem().Kind() != reflect.Struct {
+			return decodeScalar(t, param, cfg)
+		}
+		arg := reflect.New(t.Elem())
+		if err := decodeInto(cfg, param, arg.Interface()); err != nil {
+			return reflect.Value{}, fmt.Errorf("fn: mapstructure: %w", err)
+		}
+		return arg, nil
+	case reflect.Slice:
+		// decode slice of structs to struct type using mapstructure
+		if t.Elem().Kind() == reflect.Struct {
 			rv := reflect.ValueOf(param)
-			// decode slice of structs to struct type using mapstructure
-			if fntyp.In(idx).Elem().Kind() == reflect.Struct {
-				nv := reflect.MakeSlice(fntyp.In(idx), rv.Len(), rv.Len())
-				for i := 0; i < rv.Len(); i++ {
-					ref := reflect.New(nv.Index(i).Type())
-					if err := mapstructure.Decode(rv.Index(i).Interface(), ref.Interface()); err != nil {
-						return nil, fmt.Errorf("fn: mapstructure: %s", err.Error())
-					}
-					nv.Index(i).Set(reflect.Indirect(ref))
+			nv := reflect.MakeSlice(t, rv.Len(), rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				ref := reflect.New(nv.Index(i).Type())
+				if err := decodeInto(cfg, rv.Index(i).Interface(), ref.Interface()); err != nil {
+					return reflect.Value{}, fmt.Errorf("fn: mapstructure: %w", err)
 				}
-				rv = nv
-			}
-			fnParams[idx] = rv
-		default:
-			// if int is expected but got float64 assume json-like encoding and cast float to int
-			if fntyp.In(idx).Kind() == reflect.Int && reflect.TypeOf(param).Kind() == reflect.Float64 {
-				param = int(param.(float64))
+				nv.Index(i).Set(reflect.Indirect(ref))
 			}
-			fnParams[idx] = ensureType(reflect.ValueOf(param), fntyp.In(idx))
+			return nv, nil
 		}
+		// plain-element slices (net.IP, []byte, []string, ...) still go
+		// through mapstructure so a configured DecodeHook can run, e.g.
+		// decoding a base64 string into []byte or a dotted string into
+		// net.IP -- not just struct-shaped args.
+		return decodeScalar(t, param, cfg)
+	default:
+		return decodeScalar(t, param, cfg)
 	}
-	return fnParams, nil
+}
+
+// decodeScalar decodes param into a value of type t via mapstructure, so a
+// configured DecodeHook (and WeaklyTypedInput, TagName, ...) applies
+// uniformly to scalar, pointer, map, and plain-element slice parameters --
+// not only struct-shaped ones. mapstructure itself already handles the
+// "float64 from JSON decodes into an int" case this used to special-case.
+func decodeScalar(t reflect.Type, param any, cfg *mapstructure.DecoderConfig) (reflect.Value, error) {
+	arg := reflect.New(t)
+	if err := decodeInto(cfg, param, arg.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("fn: mapstructure: %w", err)
+	}
+	return ensureType(arg.Elem(), t), nil
 }
 
 // ParseReturn splits the results of reflect.Call() into the values, and
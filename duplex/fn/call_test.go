@@ -0,0 +1,72 @@
+package fn
+
+import "testing"
+
+func sumInts(label string, nums ...int) (string, int) {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return label, total
+}
+
+type namedPoint struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func sumPoints(points ...namedPoint) int {
+	total := 0
+	for _, p := range points {
+		total += p.X + p.Y
+	}
+	return total
+}
+
+func TestCallVariadicZeroTail(t *testing.T) {
+	out, err := Call(sumInts, []any{"a"})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if out[0] != "a" || out[1] != 0 {
+		t.Fatalf("got %v", out)
+	}
+}
+
+func TestCallVariadicOneElementTail(t *testing.T) {
+	out, err := Call(sumInts, []any{"a", 3})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if out[1] != 3 {
+		t.Fatalf("got %v", out)
+	}
+}
+
+func TestCallVariadicPrebuiltSlice(t *testing.T) {
+	out, err := Call(sumInts, []any{"a", []int{1, 2, 3}})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if out[1] != 6 {
+		t.Fatalf("got %v", out)
+	}
+}
+
+// TestCallVariadicPrebuiltStructSlice exercises a pre-built slice of
+// struct-element variadic args fed as decoded JSON maps, which must be
+// converted element-by-element with mapstructure rather than matched by
+// identical element type.
+func TestCallVariadicPrebuiltStructSlice(t *testing.T) {
+	points := []map[string]any{
+		{"x": 1, "y": 2},
+		{"x": 3, "y": 4},
+	}
+	out, err := Call(sumPoints, []any{points})
+	if err != nil {
+		t.Fatalf("Call: %v", err)
+	}
+	if out[0] != 10 {
+		t.Fatalf("got %v", out)
+	}
+}
@@ -0,0 +1,108 @@
+// Package hooks provides composable mapstructure.DecodeHookFunc
+// implementations for use with fn.WithDecodeHook. Each hook converts a
+// string-encoded value into a richer Go type; combine several with
+// mapstructure.ComposeDecodeHookFunc.
+package hooks
+
+import (
+	"encoding"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	timeType            = reflect.TypeOf(time.Time{})
+	ipType              = reflect.TypeOf(net.IP{})
+	ipNetType           = reflect.TypeOf(net.IPNet{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// StringToTimeDuration converts a string to a time.Duration using
+// time.ParseDuration, e.g. "1h30m" -> 90 minutes.
+func StringToTimeDuration() mapstructure.DecodeHookFunc {
+	return func(f, t reflect.Type, data any) (any, error) {
+		if f.Kind() != reflect.String || t != durationType {
+			return data, nil
+		}
+		return time.ParseDuration(data.(string))
+	}
+}
+
+// StringToTime converts a string to a time.Time by parsing it with the
+// given layout, e.g. time.RFC3339.
+func StringToTime(layout string) mapstructure.DecodeHookFunc {
+	return func(f, t reflect.Type, data any) (any, error) {
+		if f.Kind() != reflect.String || t != timeType {
+			return data, nil
+		}
+		return time.Parse(layout, data.(string))
+	}
+}
+
+// StringToSlice splits a string on sep, letting a single delimited string
+// stand in for a JSON/YAML array of strings.
+func StringToSlice(sep string) mapstructure.DecodeHookFunc {
+	return func(f, t reflect.Type, data any) (any, error) {
+		if f.Kind() != reflect.String || t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.String {
+			return data, nil
+		}
+		s := data.(string)
+		if s == "" {
+			return []string{}, nil
+		}
+		return strings.Split(s, sep), nil
+	}
+}
+
+// StringToIP converts a string to a net.IP using net.ParseIP.
+func StringToIP() mapstructure.DecodeHookFunc {
+	return func(f, t reflect.Type, data any) (any, error) {
+		if f.Kind() != reflect.String || t != ipType {
+			return data, nil
+		}
+		s := data.(string)
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("hooks: invalid IP address %q", s)
+		}
+		return ip, nil
+	}
+}
+
+// StringToIPNet converts a CIDR string to a net.IPNet using net.ParseCIDR.
+func StringToIPNet() mapstructure.DecodeHookFunc {
+	return func(f, t reflect.Type, data any) (any, error) {
+		if f.Kind() != reflect.String || t != ipNetType {
+			return data, nil
+		}
+		_, ipNet, err := net.ParseCIDR(data.(string))
+		if err != nil {
+			return nil, err
+		}
+		return *ipNet, nil
+	}
+}
+
+// TextUnmarshaller decodes a string into any type implementing
+// encoding.TextUnmarshaler, such as big.Int or a custom identifier type.
+func TextUnmarshaller() mapstructure.DecodeHookFunc {
+	return func(f, t reflect.Type, data any) (any, error) {
+		if f.Kind() != reflect.String {
+			return data, nil
+		}
+		if !reflect.PointerTo(t).Implements(textUnmarshalerType) {
+			return data, nil
+		}
+		result := reflect.New(t)
+		if err := result.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(data.(string))); err != nil {
+			return nil, err
+		}
+		return result.Elem().Interface(), nil
+	}
+}
@@ -0,0 +1,74 @@
+package hooks
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+func decode(t *testing.T, hook mapstructure.DecodeHookFunc, input, result any) {
+	t.Helper()
+	cfg := &mapstructure.DecoderConfig{DecodeHook: hook, Result: result}
+	decoder, err := mapstructure.NewDecoder(cfg)
+	if err != nil {
+		t.Fatalf("NewDecoder: %v", err)
+	}
+	if err := decoder.Decode(input); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+}
+
+func TestStringToTimeDuration(t *testing.T) {
+	var d time.Duration
+	decode(t, StringToTimeDuration(), "1h30m", &d)
+	if d != 90*time.Minute {
+		t.Fatalf("got %v", d)
+	}
+}
+
+func TestStringToTime(t *testing.T) {
+	var tm time.Time
+	decode(t, StringToTime(time.RFC3339), "2024-01-02T15:04:05Z", &tm)
+	want := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	if !tm.Equal(want) {
+		t.Fatalf("got %v, want %v", tm, want)
+	}
+}
+
+func TestStringToSlice(t *testing.T) {
+	var s []string
+	decode(t, StringToSlice(","), "a,b,c", &s)
+	if len(s) != 3 || s[0] != "a" || s[2] != "c" {
+		t.Fatalf("got %v", s)
+	}
+}
+
+func TestStringToIP(t *testing.T) {
+	var ip net.IP
+	decode(t, StringToIP(), "192.168.1.1", &ip)
+	if ip.String() != "192.168.1.1" {
+		t.Fatalf("got %v", ip)
+	}
+}
+
+func TestStringToIPInvalid(t *testing.T) {
+	var ip net.IP
+	cfg := &mapstructure.DecoderConfig{DecodeHook: StringToIP(), Result: &ip}
+	decoder, err := mapstructure.NewDecoder(cfg)
+	if err != nil {
+		t.Fatalf("NewDecoder: %v", err)
+	}
+	if err := decoder.Decode("not-an-ip"); err == nil {
+		t.Fatal("expected an error for an invalid IP")
+	}
+}
+
+func TestStringToIPNet(t *testing.T) {
+	var ipNet net.IPNet
+	decode(t, StringToIPNet(), "10.0.0.0/8", &ipNet)
+	if ipNet.String() != "10.0.0.0/8" {
+		t.Fatalf("got %v", ipNet.String())
+	}
+}
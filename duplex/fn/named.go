@@ -0,0 +1,58 @@
+package fn
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// CallNamed invokes fn using named rather than positional arguments.
+//
+// If fn has exactly one struct (or pointer-to-struct) parameter, args is
+// mapstructure-decoded into it directly, so its fields become the named
+// surface. Otherwise each entry in args is matched by the parameter names
+// fn was registered with via Register and converted with the same
+// struct/slice logic ArgsTo already uses for positional calls. A trailing
+// variadic parameter is optional: if its registered name is absent from
+// args, fn is called with zero variadic elements. This is the natural
+// shape for JSON-RPC 2.0 "by-name" params.
+func CallNamed(fn any, args map[string]any, opts ...Option) ([]any, error) {
+	fnval := reflect.ValueOf(fn)
+	if rv, ok := fn.(reflect.Value); ok {
+		fnval = rv
+	}
+	fntyp := fnval.Type()
+
+	if fntyp.NumIn() == 1 && isStructLike(fntyp.In(0)) {
+		return CallWith(fn, []any{args}, opts...)
+	}
+
+	_, paramNames := paramNamesFor(fnval)
+	if len(paramNames) < fntyp.NumIn() {
+		return nil, fmt.Errorf("fn: CallNamed requires %d parameter names registered via Register, got %d", fntyp.NumIn(), len(paramNames))
+	}
+
+	fixed := fntyp.NumIn()
+	if fntyp.IsVariadic() {
+		fixed--
+	}
+	positional := make([]any, 0, fntyp.NumIn())
+	for _, name := range paramNames[:fixed] {
+		v, ok := args[name]
+		if !ok {
+			return nil, fmt.Errorf("fn: missing named argument %q", name)
+		}
+		positional = append(positional, v)
+	}
+	if fntyp.IsVariadic() {
+		if v, ok := args[paramNames[fixed]]; ok {
+			positional = append(positional, v)
+		}
+	}
+	return CallWith(fn, positional, opts...)
+}
+
+// isStructLike reports whether t is a struct or a pointer to one, the two
+// shapes mapstructure can decode a whole map into directly.
+func isStructLike(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct || (t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Struct)
+}
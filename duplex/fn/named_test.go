@@ -0,0 +1,57 @@
+package fn
+
+import "testing"
+
+type createUserArgs struct {
+	Name string
+	Age  int
+}
+
+func createUser(args createUserArgs) string {
+	return args.Name
+}
+
+func add(a, b int) int {
+	return a + b
+}
+
+func TestCallNamedSingleStructParam(t *testing.T) {
+	out, err := CallNamed(createUser, map[string]any{"Name": "Ada", "Age": 30})
+	if err != nil {
+		t.Fatalf("CallNamed: %v", err)
+	}
+	if out[0] != "Ada" {
+		t.Fatalf("got %v", out[0])
+	}
+}
+
+func TestCallNamedRegisteredMultiParam(t *testing.T) {
+	Register("fn_test.add", add, "a", "b")
+
+	out, err := CallNamed(add, map[string]any{"a": 2, "b": 3})
+	if err != nil {
+		t.Fatalf("CallNamed: %v", err)
+	}
+	if out[0] != 5 {
+		t.Fatalf("got %v", out[0])
+	}
+}
+
+func TestCallNamedMissingRegistration(t *testing.T) {
+	// Distinct signature/body from add, so the compiler can't dedup this
+	// closure onto add's function pointer and accidentally "inherit" its
+	// registration.
+	unregistered := func(a, b, c int) int { return a*2 + b*3 + c }
+
+	if _, err := CallNamed(unregistered, map[string]any{"a": 1, "b": 2, "c": 3}); err == nil {
+		t.Fatal("expected an error for a multi-param function with no registered names")
+	}
+}
+
+func TestCallNamedMissingArgument(t *testing.T) {
+	Register("fn_test.add2", add, "a", "b")
+
+	if _, err := CallNamed(add, map[string]any{"a": 1}); err == nil {
+		t.Fatal("expected an error for a missing named argument")
+	}
+}
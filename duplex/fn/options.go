@@ -0,0 +1,85 @@
+package fn
+
+import "github.com/mitchellh/mapstructure"
+
+// Option configures the mapstructure.DecoderConfig used by ArgsTo and
+// CallWith when converting arguments.
+type Option func(*mapstructure.DecoderConfig)
+
+// WithDecodeHook sets the DecodeHook used when converting arguments,
+// allowing custom string-to-type conversions (e.g. RFC3339 strings to
+// time.Time). Compose multiple hooks with mapstructure.ComposeDecodeHookFunc.
+func WithDecodeHook(hook mapstructure.DecodeHookFunc) Option {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.DecodeHook = hook
+	}
+}
+
+// WithTagName overrides the struct tag mapstructure uses to match map keys
+// to struct fields. Defaults to "mapstructure".
+func WithTagName(tag string) Option {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.TagName = tag
+	}
+}
+
+// WithWeaklyTypedInput enables mapstructure's weakly typed input mode, which
+// allows loose conversions such as "1" -> 1 or 1 -> true. Useful when
+// arguments originate from JSON/YAML payloads with imprecise typing.
+func WithWeaklyTypedInput(weak bool) Option {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.WeaklyTypedInput = weak
+	}
+}
+
+// WithErrorUnused makes decoding fail if a struct argument's source map
+// contains keys that don't correspond to any field, instead of silently
+// dropping them. Combine with WithErrorUnset to catch payload/type drift
+// early, e.g. when Call is fed by JSON-RPC or LLM tool-call arguments.
+func WithErrorUnused(strict bool) Option {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.ErrorUnused = strict
+	}
+}
+
+// WithErrorUnset makes decoding fail if a struct argument has fields left
+// unset by its source map, instead of silently leaving them zero-valued.
+func WithErrorUnset(strict bool) Option {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.ErrorUnset = strict
+	}
+}
+
+// WithMetadata records, into meta, which keys were consumed and which were
+// ignored while decoding struct arguments. meta accumulates across every
+// struct/slice-of-struct argument in a single Call, useful for validating a
+// tool-call payload before or after dispatch.
+func WithMetadata(meta *mapstructure.Metadata) Option {
+	return func(cfg *mapstructure.DecoderConfig) {
+		cfg.Metadata = meta
+	}
+}
+
+// newDecoderConfig builds a mapstructure.DecoderConfig from the given
+// options. The returned config has no Result set; callers must copy it and
+// set Result before constructing a decoder.
+func newDecoderConfig(opts []Option) *mapstructure.DecoderConfig {
+	cfg := &mapstructure.DecoderConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// decodeInto decodes input into result using a copy of cfg, so the same
+// base config can be reused across multiple arguments without the Result
+// field leaking between calls.
+func decodeInto(cfg *mapstructure.DecoderConfig, input, result any) error {
+	dup := *cfg
+	dup.Result = result
+	decoder, err := mapstructure.NewDecoder(&dup)
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(input)
+}
@@ -0,0 +1,43 @@
+package fn
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gysddn/toolkit-go/duplex/fn/hooks"
+)
+
+func parseIP(ip net.IP) string {
+	return ip.String()
+}
+
+func parseDuration(d time.Duration) time.Duration {
+	return d
+}
+
+func TestCallWithDecodeHookTopLevelIP(t *testing.T) {
+	out, err := CallWith(parseIP, []any{"192.168.1.1"}, WithDecodeHook(hooks.StringToIP()))
+	if err != nil {
+		t.Fatalf("CallWith: %v", err)
+	}
+	if out[0] != "192.168.1.1" {
+		t.Fatalf("got %v", out[0])
+	}
+}
+
+func TestCallWithDecodeHookTopLevelDuration(t *testing.T) {
+	out, err := CallWith(parseDuration, []any{"1h30m"}, WithDecodeHook(hooks.StringToTimeDuration()))
+	if err != nil {
+		t.Fatalf("CallWith: %v", err)
+	}
+	if out[0] != 90*time.Minute {
+		t.Fatalf("got %v", out[0])
+	}
+}
+
+func TestCallWithoutDecodeHookFailsOnString(t *testing.T) {
+	if _, err := CallWith(parseDuration, []any{"1h30m"}); err == nil {
+		t.Fatal("expected an error decoding a string into time.Duration without a DecodeHook")
+	}
+}
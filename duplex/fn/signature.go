@@ -0,0 +1,270 @@
+package fn
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// registration records the metadata supplied to Register for a function:
+// the name it should be exposed under and, since reflect cannot recover
+// them, its parameter names.
+type registration struct {
+	name       string
+	paramNames []string
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = map[uintptr]registration{}
+)
+
+// Register associates fn with a name and, optionally, its parameter names,
+// so that Describe and JSONSchema can expose meaningful field names instead
+// of positional placeholders. Intended for init-time setup, in the same
+// spirit as sql.Register.
+func Register(name string, fn any, paramNames ...string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[reflect.ValueOf(fn).Pointer()] = registration{name: name, paramNames: paramNames}
+}
+
+// paramNamesFor looks up the registration for fnval, if any, returning its
+// name and parameter names.
+func paramNamesFor(fnval reflect.Value) (name string, paramNames []string) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	reg, ok := registry[fnval.Pointer()]
+	if !ok {
+		return "", nil
+	}
+	return reg.name, reg.paramNames
+}
+
+// Type describes a Go type well enough to render it as a JSON Schema
+// fragment: its JSON-ish kind, and, for composite types, its element or
+// field types.
+type Type struct {
+	Kind       string          `json:"kind"`
+	Properties map[string]Type `json:"properties,omitempty"`
+	Required   []string        `json:"required,omitempty"`
+	Items      *Type           `json:"items,omitempty"`
+}
+
+// Param describes a single function parameter. Variadic and pointer
+// parameters are Optional: a caller may omit them entirely (a variadic
+// parameter with zero elements, or a nil pointer).
+type Param struct {
+	Name     string `json:"name"`
+	Variadic bool   `json:"variadic,omitempty"`
+	Optional bool   `json:"optional,omitempty"`
+	Type     `json:"type"`
+}
+
+// Signature describes a Go function's parameters and return values well
+// enough to expose it as a JSON-RPC endpoint or an LLM tool definition.
+type Signature struct {
+	Name    string  `json:"name,omitempty"`
+	Params  []Param `json:"params"`
+	Returns []Type  `json:"returns,omitempty"`
+}
+
+// Describe walks fn's reflect.Type and produces a serializable Signature
+// describing its parameters and returns, recursing into struct fields,
+// slices, maps, and pointers, and honoring json/mapstructure tags for
+// field names. Parameter names are only available if fn was registered
+// with Register; otherwise they default to "arg0", "arg1", and so on.
+func Describe(fn any) Signature {
+	fnval := reflect.ValueOf(fn)
+	if rv, ok := fn.(reflect.Value); ok {
+		fnval = rv
+	}
+	fntyp := fnval.Type()
+	name, paramNames := paramNamesFor(fnval)
+
+	sig := Signature{Name: name}
+	for i := 0; i < fntyp.NumIn(); i++ {
+		variadic := fntyp.IsVariadic() && i == fntyp.NumIn()-1
+		t := fntyp.In(i)
+		if variadic {
+			t = t.Elem()
+		}
+		optional := variadic || t.Kind() == reflect.Pointer
+		pname := "arg" + strconv.Itoa(i)
+		if i < len(paramNames) {
+			pname = paramNames[i]
+		}
+		sig.Params = append(sig.Params, Param{Name: pname, Variadic: variadic, Optional: optional, Type: typeOf(t)})
+	}
+	for i := 0; i < fntyp.NumOut(); i++ {
+		if fntyp.Out(i) == errorInterface {
+			continue
+		}
+		sig.Returns = append(sig.Returns, typeOf(fntyp.Out(i)))
+	}
+	return sig
+}
+
+// typeOf recursively describes t, dereferencing pointers and recursing
+// into struct fields (honoring json/mapstructure tags), slice/array
+// elements, and map values.
+func typeOf(t reflect.Type) Type {
+	return typeOfVisiting(t, make(map[reflect.Type]bool))
+}
+
+// typeOfVisiting is typeOf's recursive worker. visiting tracks the struct
+// types on the current recursion path so a self- or mutually-referential
+// type (e.g. type Node struct{ Next *Node }) stops recursing instead of
+// overflowing the stack.
+func typeOfVisiting(t reflect.Type, visiting map[reflect.Type]bool) Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return Type{Kind: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Type{Kind: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Type{Kind: "number"}
+	case reflect.Slice, reflect.Array:
+		items := typeOfVisiting(t.Elem(), visiting)
+		return Type{Kind: "array", Items: &items}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			// JSON (and JSON Schema) objects only ever have string keys;
+			// map[int]string and friends have no valid representation, so
+			// describe them as an opaque object instead of silently
+			// mislabeling the value type as keyed by string.
+			return Type{Kind: "object"}
+		}
+		values := typeOfVisiting(t.Elem(), visiting)
+		return Type{Kind: "object", Items: &values}
+	case reflect.Struct:
+		if visiting[t] {
+			// already on the current recursion path: describe it as an
+			// opaque object rather than recursing forever.
+			return Type{Kind: "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		props := map[string]Type{}
+		var required []string
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				continue // unexported
+			}
+			name, omitempty, skip := fieldTag(field)
+			if skip {
+				continue
+			}
+			props[name] = typeOfVisiting(field.Type, visiting)
+			if !omitempty {
+				required = append(required, name)
+			}
+		}
+		return Type{Kind: "object", Properties: props, Required: required}
+	default:
+		return Type{Kind: "string"}
+	}
+}
+
+// fieldTag resolves a struct field's exported name from its json tag,
+// falling back to mapstructure, then the Go field name. It reports
+// omitempty so Signature/JSONSchema can decide whether the field is
+// required, and skip for fields explicitly tagged "-".
+func fieldTag(field reflect.StructField) (name string, omitempty, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		tag = field.Tag.Get("mapstructure")
+	}
+	parts := strings.Split(tag, ",")
+	if parts[0] == "-" && len(parts) == 1 {
+		return "", false, true
+	}
+	name = parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
+
+// JSONSchema renders fn's Signature (see Describe) as a JSON Schema object
+// describing its parameters, suitable for use as an OpenAI-style
+// function-calling tool definition or a JSON-RPC params schema. A variadic
+// parameter is rendered as an array of its element type rather than the
+// element type itself. Variadic and pointer parameters are omitted from
+// "required" since both can be left out of a call. When strict is true,
+// the top-level object and every object describing a struct set
+// "additionalProperties":false, rejecting unknown fields; pass false to
+// allow them. Objects describing string-keyed maps carry their value
+// schema under "additionalProperties" instead, and opaque objects
+// (non-string-keyed maps, recursive struct references) set none.
+func JSONSchema(fn any, strict bool) ([]byte, error) {
+	sig := Describe(fn)
+	properties := make(map[string]any, len(sig.Params))
+	required := make([]string, 0, len(sig.Params))
+	for _, p := range sig.Params {
+		item := schemaOf(p.Type, strict)
+		if p.Variadic {
+			properties[p.Name] = map[string]any{"type": "array", "items": item}
+		} else {
+			properties[p.Name] = item
+		}
+		if !p.Optional {
+			required = append(required, p.Name)
+		}
+	}
+	schema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if strict {
+		schema["additionalProperties"] = false
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return json.Marshal(schema)
+}
+
+// schemaOf renders a Type as a JSON Schema fragment. When strict is true,
+// every object fragment with properties (a struct) sets
+// "additionalProperties":false; a map fragment sets it to the value
+// schema regardless of strict, and an opaque object fragment sets none.
+func schemaOf(t Type, strict bool) map[string]any {
+	s := map[string]any{"type": t.Kind}
+	switch t.Kind {
+	case "object":
+		if t.Properties != nil {
+			properties := make(map[string]any, len(t.Properties))
+			for name, field := range t.Properties {
+				properties[name] = schemaOf(field, strict)
+			}
+			s["properties"] = properties
+			if len(t.Required) > 0 {
+				s["required"] = t.Required
+			}
+			if strict {
+				s["additionalProperties"] = false
+			}
+		} else if t.Items != nil {
+			s["additionalProperties"] = schemaOf(*t.Items, strict)
+		}
+	case "array":
+		if t.Items != nil {
+			s["items"] = schemaOf(*t.Items, strict)
+		}
+	}
+	return s
+}
@@ -0,0 +1,131 @@
+package fn
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type address struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type person struct {
+	Name    string  `json:"name"`
+	Age     int     `json:"age"`
+	Address address `json:"address"`
+}
+
+func describePerson(p person, tags ...string) string {
+	return p.Name
+}
+
+type node struct {
+	Value int
+	Next  *node
+}
+
+func walk(n node) int {
+	return n.Value
+}
+
+func withIntKeyMap(m map[int]string) int {
+	return len(m)
+}
+
+func TestDescribeVariadicAndNested(t *testing.T) {
+	sig := Describe(describePerson)
+	if len(sig.Params) != 2 {
+		t.Fatalf("got %d params", len(sig.Params))
+	}
+
+	p := sig.Params[0]
+	if p.Variadic || p.Optional {
+		t.Fatalf("expected the struct param to be required, got %+v", p)
+	}
+	if p.Properties["address"].Kind != "object" {
+		t.Fatalf("expected nested address to describe as an object, got %+v", p.Properties["address"])
+	}
+	if len(p.Properties["address"].Properties) != 2 {
+		t.Fatalf("got address properties %+v", p.Properties["address"].Properties)
+	}
+
+	tags := sig.Params[1]
+	if !tags.Variadic || !tags.Optional {
+		t.Fatalf("expected the trailing param to be variadic and optional, got %+v", tags)
+	}
+	if tags.Kind != "string" {
+		t.Fatalf("expected the variadic element type, got %+v", tags)
+	}
+}
+
+func TestDescribeRecursiveStruct(t *testing.T) {
+	sig := Describe(walk) // must not stack-overflow on the self-referential *node field
+	if sig.Params[0].Kind != "object" {
+		t.Fatalf("got %+v", sig.Params[0])
+	}
+	if sig.Params[0].Properties["Next"].Kind != "object" {
+		t.Fatalf("got %+v", sig.Params[0].Properties["Next"])
+	}
+}
+
+func TestDescribeNonStringMapKey(t *testing.T) {
+	sig := Describe(withIntKeyMap)
+	p := sig.Params[0]
+	if p.Kind != "object" || p.Items != nil {
+		t.Fatalf("expected a keyless placeholder for a non-string-keyed map, got %+v", p)
+	}
+}
+
+func TestJSONSchemaVariadicAsArray(t *testing.T) {
+	raw, err := JSONSchema(describePerson, false)
+	if err != nil {
+		t.Fatalf("JSONSchema: %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	props := schema["properties"].(map[string]any)
+	tags := props["arg1"].(map[string]any)
+	if tags["type"] != "array" {
+		t.Fatalf("expected the variadic param to render as an array, got %v", tags)
+	}
+	if items, ok := tags["items"].(map[string]any); !ok || items["type"] != "string" {
+		t.Fatalf("got items %v", tags["items"])
+	}
+
+	required, _ := schema["required"].([]any)
+	for _, name := range required {
+		if name == "arg1" {
+			t.Fatalf("variadic param must not be required: %v", required)
+		}
+	}
+}
+
+func TestJSONSchemaStrictSetsAdditionalPropertiesFalse(t *testing.T) {
+	strict, err := JSONSchema(describePerson, true)
+	if err != nil {
+		t.Fatalf("JSONSchema: %v", err)
+	}
+	var strictSchema map[string]any
+	if err := json.Unmarshal(strict, &strictSchema); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if strictSchema["additionalProperties"] != false {
+		t.Fatalf("expected additionalProperties:false in strict mode, got %v", strictSchema["additionalProperties"])
+	}
+
+	loose, err := JSONSchema(describePerson, false)
+	if err != nil {
+		t.Fatalf("JSONSchema: %v", err)
+	}
+	var looseSchema map[string]any
+	if err := json.Unmarshal(loose, &looseSchema); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if _, ok := looseSchema["additionalProperties"]; ok {
+		t.Fatalf("expected no additionalProperties outside strict mode, got %v", looseSchema["additionalProperties"])
+	}
+}
@@ -0,0 +1,61 @@
+package fn
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+type greeting struct {
+	Name string
+}
+
+func greet(g greeting) string {
+	return "hello " + g.Name
+}
+
+func TestCallWithErrorUnused(t *testing.T) {
+	args := []any{map[string]any{"Name": "Ada", "Extra": "surprise"}}
+
+	if _, err := CallWith(greet, args); err != nil {
+		t.Fatalf("CallWith without strict mode: %v", err)
+	}
+
+	_, err := CallWith(greet, args, WithErrorUnused(true))
+	if err == nil {
+		t.Fatal("expected an error for an unused field in strict mode")
+	}
+	var merr *mapstructure.Error
+	if !errors.As(err, &merr) {
+		t.Fatalf("expected a *mapstructure.Error, got %T: %v", err, err)
+	}
+}
+
+func TestCallWithErrorUnset(t *testing.T) {
+	args := []any{map[string]any{}}
+
+	_, err := CallWith(greet, args, WithErrorUnset(true))
+	if err == nil {
+		t.Fatal("expected an error for an unset field in strict mode")
+	}
+	var merr *mapstructure.Error
+	if !errors.As(err, &merr) {
+		t.Fatalf("expected a *mapstructure.Error, got %T: %v", err, err)
+	}
+}
+
+func TestCallWithMetadata(t *testing.T) {
+	var meta mapstructure.Metadata
+	args := []any{map[string]any{"Name": "Ada", "Extra": "surprise"}}
+
+	if _, err := CallWith(greet, args, WithMetadata(&meta)); err != nil {
+		t.Fatalf("CallWith: %v", err)
+	}
+	if len(meta.Keys) != 1 || meta.Keys[0] != "Name" {
+		t.Fatalf("got Keys=%v", meta.Keys)
+	}
+	if len(meta.Unused) != 1 || meta.Unused[0] != "Extra" {
+		t.Fatalf("got Unused=%v", meta.Unused)
+	}
+}